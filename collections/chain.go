@@ -0,0 +1,109 @@
+package collections
+
+import "sort"
+
+// ListChain is a fluent, chainable wrapper around List[T], e.g.:
+//
+//	Chain(l).Where(isEven).Sort(less).Take(3).ToArray()
+//
+// Type-changing operations are package-level functions; see ChainMap and ChainReduce.
+type ListChain[T CollectionElement] struct {
+	list *List[T]
+}
+
+// Chain wraps an existing List[T] in a ListChain[T].
+func Chain[T CollectionElement](l *List[T]) *ListChain[T] {
+	return &ListChain[T]{list: l}
+}
+
+// NewChainFromArray builds a ListChain[T] from a plain slice.
+func NewChainFromArray[T CollectionElement](array []T) *ListChain[T] {
+	return Chain(NewListFromArray(array))
+}
+
+// Filter returns a chain over the elements for which f returns true.
+func (c *ListChain[T]) Filter(f func(T) bool) *ListChain[T] {
+	return Chain(c.list.Where(f))
+}
+
+// Where is an alias of Filter, matching List[T].Where's naming.
+func (c *ListChain[T]) Where(f func(T) bool) *ListChain[T] {
+	return c.Filter(f)
+}
+
+// Distinct returns a chain over the unique elements of the current chain.
+func (c *ListChain[T]) Distinct() *ListChain[T] {
+	return Chain(c.list.Distinct())
+}
+
+// Sort returns a chain with elements sorted by the given less function. The
+// sort is stable and does not mutate the wrapped list.
+func (c *ListChain[T]) Sort(less func(a, b T) bool) *ListChain[T] {
+	sorted := append([]T(nil), c.list.items...)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	return Chain(NewListFromArray(sorted))
+}
+
+// Reverse returns a chain with the elements in reverse order.
+func (c *ListChain[T]) Reverse() *ListChain[T] {
+	items := c.list.items
+	reversed := make([]T, len(items))
+	for i, e := range items {
+		reversed[len(items)-1-i] = e
+	}
+	return Chain(NewListFromArray(reversed))
+}
+
+// Take returns a chain over at most the first n elements.
+func (c *ListChain[T]) Take(n int) *ListChain[T] {
+	if n < 0 {
+		n = 0
+	}
+	if n > c.list.Size() {
+		n = c.list.Size()
+	}
+	return Chain(NewListFromArray(c.list.items[:n]))
+}
+
+// Skip returns a chain with the first n elements dropped.
+func (c *ListChain[T]) Skip(n int) *ListChain[T] {
+	if n < 0 {
+		n = 0
+	}
+	if n > c.list.Size() {
+		n = c.list.Size()
+	}
+	return Chain(NewListFromArray(c.list.items[n:]))
+}
+
+// Tap invokes f with the current wrapped list for a side effect (e.g.
+// logging or collecting stats) and returns the chain unchanged.
+func (c *ListChain[T]) Tap(f func(*List[T])) *ListChain[T] {
+	f(c.list)
+	return c
+}
+
+// ToList unwraps the chain, returning the underlying List[T].
+func (c *ListChain[T]) ToList() *List[T] {
+	return c.list
+}
+
+// ToArray unwraps the chain, returning the underlying elements as a slice.
+func (c *ListChain[T]) ToArray() []T {
+	return c.list.ToArray()
+}
+
+// ChainMap transforms a ListChain[T] into a ListChain[R].
+func ChainMap[T CollectionElement, R CollectionElement](c *ListChain[T], callback mapListFunction[T, R]) *ListChain[R] {
+	return Chain(MapList(c.list, callback))
+}
+
+// ChainReduce reduces a ListChain[T] to a single value of type R, which may
+// differ from T.
+func ChainReduce[T CollectionElement, R any](c *ListChain[T], callback func(result R, item T) R, initialValue R) R {
+	result := initialValue
+	for _, e := range c.list.items {
+		result = callback(result, e)
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package collections
+
+import (
+	"strconv"
+	"testing"
+)
+
+func assertIntSlice(t *testing.T, got, want []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestChainFilterWhereToArray(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5, 6})
+
+	got := Chain(l).Filter(func(i int) bool { return i%2 == 0 }).ToArray()
+	assertIntSlice(t, got, []int{2, 4, 6})
+
+	got = Chain(l).Where(func(i int) bool { return i > 4 }).ToArray()
+	assertIntSlice(t, got, []int{5, 6})
+}
+
+func TestChainDistinct(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 2, 3, 1})
+	got := Chain(l).Distinct().ToArray()
+	assertIntSlice(t, got, []int{1, 2, 3})
+}
+
+func TestChainSortDoesNotMutateOriginal(t *testing.T) {
+	l := NewListFromArray([]int{3, 1, 2})
+
+	sorted := Chain(l).Sort(func(a, b int) bool { return a < b }).ToArray()
+	assertIntSlice(t, sorted, []int{1, 2, 3})
+	assertIntSlice(t, l.ToArray(), []int{3, 1, 2})
+}
+
+func TestChainReverse(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	got := Chain(l).Reverse().ToArray()
+	assertIntSlice(t, got, []int{3, 2, 1})
+}
+
+func TestChainTakeAndSkip(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	assertIntSlice(t, Chain(l).Take(2).ToArray(), []int{1, 2})
+	assertIntSlice(t, Chain(l).Take(100).ToArray(), []int{1, 2, 3, 4, 5})
+	assertIntSlice(t, Chain(l).Skip(3).ToArray(), []int{4, 5})
+	assertIntSlice(t, Chain(l).Skip(100).ToArray(), []int{})
+}
+
+func TestChainTapDoesNotAlterPipeline(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	var seenSize int
+
+	got := Chain(l).Tap(func(snapshot *List[int]) {
+		seenSize = snapshot.Size()
+	}).ToArray()
+
+	if seenSize != 3 {
+		t.Errorf("Tap callback saw size %d, want 3", seenSize)
+	}
+	assertIntSlice(t, got, []int{1, 2, 3})
+}
+
+func TestChainPipeline(t *testing.T) {
+	l := NewListFromArray([]int{5, 3, 1, 4, 2, 6})
+
+	got := Chain(l).
+		Where(func(i int) bool { return i%2 == 0 }).
+		Sort(func(a, b int) bool { return a < b }).
+		Take(2).
+		ToArray()
+
+	assertIntSlice(t, got, []int{2, 4})
+}
+
+func TestChainMapChangesType(t *testing.T) {
+	c := NewChainFromArray([]int{1, 2, 3})
+
+	result := ChainMap(c, func(item int, index int) string {
+		return strconv.Itoa(item)
+	})
+
+	got := result.ToArray()
+	want := []string{"1", "2", "3"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestChainReduce(t *testing.T) {
+	c := NewChainFromArray([]int{1, 2, 3, 4})
+
+	sum := ChainReduce(c, func(result int, item int) int {
+		return result + item
+	}, 0)
+
+	if sum != 10 {
+		t.Errorf("got %d, want 10", sum)
+	}
+}
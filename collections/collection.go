@@ -0,0 +1,20 @@
+package collections
+
+// CollectionElement is the constraint satisfied by elements stored in this
+// package's collections.
+type CollectionElement interface {
+	comparable
+}
+
+// CollectionType identifies the concrete kind of a Collection implementation.
+type CollectionType int
+
+const (
+	TypeList CollectionType = iota
+)
+
+// Collection is implemented by every container type in this package.
+type Collection interface {
+	Size() int
+	Type() CollectionType
+}
@@ -0,0 +1,128 @@
+package collections
+
+import "errors"
+
+// GroupBy groups the elements of l by the key returned by keyFn.
+func GroupBy[T CollectionElement, K comparable](l *List[T], keyFn func(T) K) map[K]*List[T] {
+	groups := make(map[K]*List[T])
+	for _, e := range l.items {
+		k := keyFn(e)
+		group, ok := groups[k]
+		if !ok {
+			group = NewEmptyList[T](0)
+			groups[k] = group
+		}
+		group.Add(e)
+	}
+	return groups
+}
+
+// PartitionBy groups the elements of l by the key returned by keyFn, like
+// GroupBy, but returns the groups as a slice ordered by first appearance of
+// each key instead of an unordered map.
+func PartitionBy[T CollectionElement, K comparable](l *List[T], keyFn func(T) K) []*List[T] {
+	groups := make(map[K]*List[T])
+	var order []K
+
+	for _, e := range l.items {
+		k := keyFn(e)
+		group, ok := groups[k]
+		if !ok {
+			group = NewEmptyList[T](0)
+			groups[k] = group
+			order = append(order, k)
+		}
+		group.Add(e)
+	}
+
+	result := make([]*List[T], 0, len(order))
+	for _, k := range order {
+		result = append(result, groups[k])
+	}
+	return result
+}
+
+// Chunk splits l into fixed-size sublists of size elements, with the last
+// sublist holding the remainder if l's length is not a multiple of size.
+// Returns an error if size is not greater than zero.
+func Chunk[T CollectionElement](l *List[T], size int) ([]*List[T], error) {
+	if size <= 0 {
+		return nil, errors.New("INVALID_CHUNK_SIZE")
+	}
+
+	var chunks []*List[T]
+	for start := 0; start < len(l.items); start += size {
+		end := start + size
+		if end > len(l.items) {
+			end = len(l.items)
+		}
+		chunks = append(chunks, NewListFromArray(l.items[start:end]))
+	}
+	return chunks, nil
+}
+
+// KeyBy indexes the elements of l by the key returned by keyFn. If multiple
+// elements share a key, the last one wins.
+func KeyBy[T CollectionElement, K comparable](l *List[T], keyFn func(T) K) map[K]T {
+	result := make(map[K]T, len(l.items))
+	for _, e := range l.items {
+		result[keyFn(e)] = e
+	}
+	return result
+}
+
+// CountBy counts the elements of l grouped by the key returned by keyFn.
+func CountBy[T CollectionElement, K comparable](l *List[T], keyFn func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, e := range l.items {
+		result[keyFn(e)]++
+	}
+	return result
+}
+
+// UniqBy returns a new list containing the first element seen for each key
+// returned by keyFn, preserving the original order.
+func UniqBy[T CollectionElement, K comparable](l *List[T], keyFn func(T) K) *List[T] {
+	seen := make(map[K]bool, len(l.items))
+	result := NewEmptyList[T](len(l.items))
+
+	for _, e := range l.items {
+		k := keyFn(e)
+		if !seen[k] {
+			seen[k] = true
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// FindDuplicates returns a new list containing the elements of l that appear
+// more than once, each included a single time, preserving the order of their
+// first occurence.
+func FindDuplicates[T CollectionElement](l *List[T]) *List[T] {
+	counts := CountBy(l, func(e T) T { return e })
+	added := make(map[T]bool)
+	result := NewEmptyList[T](0)
+
+	for _, e := range l.items {
+		if counts[e] > 1 && !added[e] {
+			added[e] = true
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+// FindUniques returns a new list containing the elements of l that appear
+// exactly once, preserving their original order.
+func FindUniques[T CollectionElement](l *List[T]) *List[T] {
+	counts := CountBy(l, func(e T) T { return e })
+	result := NewEmptyList[T](0)
+
+	for _, e := range l.items {
+		if counts[e] == 1 {
+			result.Add(e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package collections
+
+import "testing"
+
+func isEven(i int) bool { return i%2 == 0 }
+
+func TestGroupBy(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5, 6})
+	groups := GroupBy(l, isEven)
+
+	assertIntSlice(t, groups[true].ToArray(), []int{2, 4, 6})
+	assertIntSlice(t, groups[false].ToArray(), []int{1, 3, 5})
+}
+
+func TestPartitionByPreservesFirstSeenOrder(t *testing.T) {
+	l := NewListFromArray([]int{3, 2, 4, 1, 6})
+	partitions := PartitionBy(l, isEven)
+
+	if len(partitions) != 2 {
+		t.Fatalf("got %d partitions, want 2", len(partitions))
+	}
+	// 3 is odd and appears first, so the odd group is first.
+	assertIntSlice(t, partitions[0].ToArray(), []int{3, 1})
+	assertIntSlice(t, partitions[1].ToArray(), []int{2, 4, 6})
+}
+
+func TestChunkSplitsWithShortLastChunk(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	chunks, err := Chunk(l, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chunks) != 3 {
+		t.Fatalf("got %d chunks, want 3", len(chunks))
+	}
+	assertIntSlice(t, chunks[0].ToArray(), []int{1, 2})
+	assertIntSlice(t, chunks[1].ToArray(), []int{3, 4})
+	assertIntSlice(t, chunks[2].ToArray(), []int{5})
+}
+
+func TestChunkExactMultiple(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4})
+
+	chunks, err := Chunk(l, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2", len(chunks))
+	}
+	assertIntSlice(t, chunks[0].ToArray(), []int{1, 2})
+	assertIntSlice(t, chunks[1].ToArray(), []int{3, 4})
+}
+
+func TestChunkRejectsNonPositiveSize(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+
+	if _, err := Chunk(l, 0); err == nil {
+		t.Error("expected an error for size 0, got nil")
+	}
+	if _, err := Chunk(l, -1); err == nil {
+		t.Error("expected an error for a negative size, got nil")
+	}
+}
+
+func TestKeyByLastWins(t *testing.T) {
+	l := NewListFromArray([]int{2, 12, 3})
+	byMod10 := KeyBy(l, func(i int) int { return i % 10 })
+
+	if byMod10[2] != 12 {
+		t.Errorf("got %d, want 12 (the later element with key 2)", byMod10[2])
+	}
+	if byMod10[3] != 3 {
+		t.Errorf("got %d, want 3", byMod10[3])
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5, 6})
+	counts := CountBy(l, isEven)
+
+	if counts[true] != 3 || counts[false] != 3 {
+		t.Errorf("got %v, want {true:3 false:3}", counts)
+	}
+}
+
+func TestUniqByKeepsFirstOccurence(t *testing.T) {
+	l := NewListFromArray([]int{1, 11, 2, 12, 3})
+	result := UniqBy(l, func(i int) int { return i % 10 })
+
+	assertIntSlice(t, result.ToArray(), []int{1, 2, 3})
+}
+
+func TestFindDuplicates(t *testing.T) {
+	l := NewListFromArray([]int{2, 1, 1, 2, 3})
+	result := FindDuplicates(l)
+
+	assertIntSlice(t, result.ToArray(), []int{2, 1})
+}
+
+func TestFindUniques(t *testing.T) {
+	l := NewListFromArray([]int{2, 1, 1, 2, 3})
+	result := FindUniques(l)
+
+	assertIntSlice(t, result.ToArray(), []int{3})
+}
@@ -38,6 +38,8 @@ func (l *List[T]) Contains(item T) bool {
 
 // Copies elements to the given List from ListTransformation that is returned by Map method.
 // Returns error if the value present in the transformation is not the same type as the List element type T.
+//
+// Deprecated: use MapList, FilterMap or FlatMap instead.
 func (l *List[T]) CopyFrom(lt ListTransformation) error {
 	for _, e := range lt.values {
 		v, ok := e.(T)
@@ -106,6 +108,8 @@ func (l *List[T]) IndexOf(item T) int {
 
 // Use Map with a callback function to transform given list to a different one.
 // Map has a return type od ListTransformation and the returned value should be passed to the CopyFrom method to generate the required list.
+//
+// Deprecated: use the package-level MapList[T, R] instead.
 func (l *List[T]) Map(callback listMapFunction[T]) ListTransformation {
 	result := make([]any, l.Size())
 	for i, e := range l.items {
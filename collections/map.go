@@ -0,0 +1,41 @@
+package collections
+
+// Type of callback function that needs to be passed to MapList.
+type mapListFunction[T CollectionElement, R CollectionElement] func(element T, index int) R
+
+// Type of callback function that needs to be passed to FilterMap.
+// The bool return value decides whether the transformed value is kept.
+type filterMapFunction[T CollectionElement, R CollectionElement] func(element T, index int) (R, bool)
+
+// Type of callback function that needs to be passed to FlatMap.
+type flatMapFunction[T CollectionElement, R CollectionElement] func(element T, index int) []R
+
+// MapList transforms a List[T] into a List[R] by applying callback to every element.
+func MapList[T CollectionElement, R CollectionElement](l *List[T], callback mapListFunction[T, R]) *List[R] {
+	result := NewEmptyList[R](l.Size())
+	for i, e := range l.items {
+		result.Add(callback(e, i))
+	}
+	return result
+}
+
+// FilterMap transforms and filters a List[T] into a List[R] in a single pass.
+func FilterMap[T CollectionElement, R CollectionElement](l *List[T], callback filterMapFunction[T, R]) *List[R] {
+	result := NewEmptyList[R](l.Size())
+	for i, e := range l.items {
+		if v, ok := callback(e, i); ok {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// FlatMap transforms every element of a List[T] into zero or more R values
+// and flattens the results into a single List[R].
+func FlatMap[T CollectionElement, R CollectionElement](l *List[T], callback flatMapFunction[T, R]) *List[R] {
+	result := NewEmptyList[R](l.Size())
+	for i, e := range l.items {
+		result.items = append(result.items, callback(e, i)...)
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package collections
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMapListChangesType(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+
+	result := MapList(l, func(item int, index int) string {
+		return strconv.Itoa(item) + "@" + strconv.Itoa(index)
+	})
+
+	want := []string{"1@0", "2@1", "3@2"}
+	got := result.ToArray()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFilterMapKeepsOnlyAccepted(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	result := FilterMap(l, func(item int, index int) (string, bool) {
+		if item%2 != 0 {
+			return "", false
+		}
+		return strconv.Itoa(item), true
+	})
+
+	want := []string{"2", "4"}
+	got := result.ToArray()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFlatMapFlattensResults(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+
+	result := FlatMap(l, func(item int, index int) []int {
+		return []int{item, item * 10}
+	})
+
+	want := []int{1, 10, 2, 20, 3, 30}
+	got := result.ToArray()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFlatMapEmptySlicesAreDropped(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+
+	result := FlatMap(l, func(item int, index int) []int {
+		if item%2 == 0 {
+			return nil
+		}
+		return []int{item}
+	})
+
+	want := []int{1, 3}
+	got := result.ToArray()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %d, want %d", i, got[i], want[i])
+		}
+	}
+}
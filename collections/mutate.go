@@ -0,0 +1,126 @@
+package collections
+
+import "errors"
+
+// Insert places item at index, shifting subsequent elements one position to
+// the right. Returns an error if index is out of range.
+func (l *List[T]) Insert(index int, item T) error {
+	if index < 0 || index > l.Size() {
+		return errors.New("INDEX_OUT_OF_RANGE")
+	}
+	l.items = append(l.items, item)
+	copy(l.items[index+1:], l.items[index:])
+	l.items[index] = item
+	return nil
+}
+
+// InsertAll places every element of items at index, preserving their
+// relative order, shifting subsequent elements to the right. Returns an
+// error if index is out of range.
+func (l *List[T]) InsertAll(index int, items []T) error {
+	if index < 0 || index > l.Size() {
+		return errors.New("INDEX_OUT_OF_RANGE")
+	}
+	l.items = append(l.items[:index], append(append([]T{}, items...), l.items[index:]...)...)
+	return nil
+}
+
+// Prepend places item at the front of the list.
+func (l *List[T]) Prepend(item T) {
+	_ = l.Insert(0, item)
+}
+
+// RemoveAt removes and returns the element at index. Returns an error if
+// index is out of range.
+func (l *List[T]) RemoveAt(index int) (item T, err error) {
+	if index < 0 || index >= l.Size() {
+		err = errors.New("INDEX_OUT_OF_RANGE")
+		return item, err
+	}
+	item = l.items[index]
+	if err := l.DeleteRange(index, index+1); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// DeleteRange removes the elements in [from, to), collapsing the slice in
+// place and zeroing out the trailing references so the GC can reclaim
+// pointer elements. Returns an error if the range is invalid.
+func (l *List[T]) DeleteRange(from, to int) error {
+	if from < 0 || to > l.Size() || from > to {
+		return errors.New("INDEX_OUT_OF_RANGE")
+	}
+
+	oldLen := l.Size()
+	l.items = append(l.items[:from], l.items[to:]...)
+
+	var zero T
+	for i := len(l.items); i < oldLen; i++ {
+		l.items[:oldLen][i] = zero
+	}
+	return nil
+}
+
+// Set replaces the element at index with item. Returns an error if index is
+// out of range.
+func (l *List[T]) Set(index int, item T) error {
+	if index < 0 || index >= l.Size() {
+		return errors.New("INDEX_OUT_OF_RANGE")
+	}
+	l.items[index] = item
+	return nil
+}
+
+// Swap exchanges the elements at i and j. Returns an error if either index is
+// out of range.
+func (l *List[T]) Swap(i, j int) error {
+	if i < 0 || i >= l.Size() || j < 0 || j >= l.Size() {
+		return errors.New("INDEX_OUT_OF_RANGE")
+	}
+	l.items[i], l.items[j] = l.items[j], l.items[i]
+	return nil
+}
+
+// Take returns a new list containing the first n elements. If n is greater
+// than the list's size, the whole list is returned.
+func (l *List[T]) Take(n int) *List[T] {
+	if n < 0 {
+		n = 0
+	}
+	if n > l.Size() {
+		n = l.Size()
+	}
+	return NewListFromArray(l.items[:n])
+}
+
+// Drop returns a new list with the first n elements removed. If n is greater
+// than the list's size, an empty list is returned.
+func (l *List[T]) Drop(n int) *List[T] {
+	if n < 0 {
+		n = 0
+	}
+	if n > l.Size() {
+		n = l.Size()
+	}
+	return NewListFromArray(l.items[n:])
+}
+
+// Slice returns a raw view of the elements in [from, to), sharing l's
+// backing array: writes through the returned slice are visible in l. Returns
+// an error if the range is invalid. Use SubList instead for a defensive copy.
+func (l *List[T]) Slice(from, to int) ([]T, error) {
+	if from < 0 || to > l.Size() || from > to {
+		return nil, errors.New("INDEX_OUT_OF_RANGE")
+	}
+	return l.items[from:to], nil
+}
+
+// SubList returns a new, defensively-copied list containing the elements in
+// [from, to). Returns an error if the range is invalid.
+func (l *List[T]) SubList(from, to int) (*List[T], error) {
+	if from < 0 || to > l.Size() || from > to {
+		return nil, errors.New("INDEX_OUT_OF_RANGE")
+	}
+	return NewListFromArray(l.items[from:to]), nil
+}
@@ -0,0 +1,196 @@
+package collections
+
+import "testing"
+
+func TestInsertShiftsSubsequentElements(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 4})
+	if err := l.Insert(2, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3, 4})
+}
+
+func TestInsertAtBoundaries(t *testing.T) {
+	l := NewListFromArray([]int{2, 3})
+	if err := l.Insert(0, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3})
+
+	if err := l.Insert(l.Size(), 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3, 4})
+}
+
+func TestInsertOutOfRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if err := l.Insert(-1, 0); err == nil {
+		t.Error("expected an error for a negative index")
+	}
+	if err := l.Insert(l.Size()+1, 0); err == nil {
+		t.Error("expected an error for an index beyond size")
+	}
+}
+
+func TestInsertAllPreservesOrder(t *testing.T) {
+	l := NewListFromArray([]int{1, 5, 6})
+	if err := l.InsertAll(1, []int{2, 3, 4}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3, 4, 5, 6})
+}
+
+func TestInsertAllOutOfRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if err := l.InsertAll(10, []int{4}); err == nil {
+		t.Error("expected an error for an index beyond size")
+	}
+}
+
+func TestPrepend(t *testing.T) {
+	l := NewListFromArray([]int{2, 3})
+	l.Prepend(1)
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3})
+}
+
+func TestRemoveAt(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4})
+
+	item, err := l.RemoveAt(1)
+	if err != nil || item != 2 {
+		t.Fatalf("got (%d, %v), want (2, nil)", item, err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 3, 4})
+}
+
+func TestRemoveAtOutOfRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if _, err := l.RemoveAt(-1); err == nil {
+		t.Error("expected an error for a negative index")
+	}
+	if _, err := l.RemoveAt(l.Size()); err == nil {
+		t.Error("expected an error for an index at size")
+	}
+}
+
+func TestDeleteRangeCollapsesSlice(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+	if err := l.DeleteRange(1, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 4, 5})
+}
+
+func TestDeleteRangeZeroesTrailingReferences(t *testing.T) {
+	a, b, c := 1, 2, 3
+	l := NewListFromArray([]*int{&a, &b, &c})
+
+	if err := l.DeleteRange(1, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The backing array beyond the new length must be zeroed so the GC can
+	// reclaim the removed pointer elements.
+	backing := l.items[:cap(l.items)]
+	for i := l.Size(); i < cap(backing); i++ {
+		if backing[i] != nil {
+			t.Errorf("backing slot %d still holds a stale pointer", i)
+		}
+	}
+}
+
+func TestDeleteRangeInvalidRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if err := l.DeleteRange(-1, 2); err == nil {
+		t.Error("expected an error for a negative from")
+	}
+	if err := l.DeleteRange(0, 10); err == nil {
+		t.Error("expected an error for a to beyond size")
+	}
+	if err := l.DeleteRange(2, 1); err == nil {
+		t.Error("expected an error when from > to")
+	}
+}
+
+func TestSet(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if err := l.Set(1, 20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{1, 20, 3})
+
+	if err := l.Set(10, 0); err == nil {
+		t.Error("expected an error for an out-of-range index")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if err := l.Swap(0, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, l.ToArray(), []int{3, 2, 1})
+
+	if err := l.Swap(0, 10); err == nil {
+		t.Error("expected an error for an out-of-range index")
+	}
+}
+
+func TestTakeAndDrop(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	assertIntSlice(t, l.Take(2).ToArray(), []int{1, 2})
+	assertIntSlice(t, l.Take(100).ToArray(), []int{1, 2, 3, 4, 5})
+	assertIntSlice(t, l.Take(-1).ToArray(), []int{})
+
+	assertIntSlice(t, l.Drop(3).ToArray(), []int{4, 5})
+	assertIntSlice(t, l.Drop(100).ToArray(), []int{})
+	assertIntSlice(t, l.Drop(-1).ToArray(), []int{1, 2, 3, 4, 5})
+}
+
+func TestSliceReturnsViewSharingBackingArray(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	view, err := l.Slice(1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, view, []int{2, 3})
+
+	view[0] = 99
+	assertIntSlice(t, l.ToArray(), []int{1, 99, 3, 4, 5})
+}
+
+func TestSliceInvalidRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if _, err := l.Slice(-1, 2); err == nil {
+		t.Error("expected an error for a negative from")
+	}
+	if _, err := l.Slice(0, 10); err == nil {
+		t.Error("expected an error for a to beyond size")
+	}
+}
+
+func TestSubListReturnsDefensiveCopy(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	sub, err := l.SubList(1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertIntSlice(t, sub.ToArray(), []int{2, 3})
+
+	sub.ToArray()[0] = 99
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3, 4, 5})
+}
+
+func TestSubListInvalidRange(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	if _, err := l.SubList(-1, 2); err == nil {
+		t.Error("expected an error for a negative from")
+	}
+	if _, err := l.SubList(2, 1); err == nil {
+		t.Error("expected an error when from > to")
+	}
+}
@@ -0,0 +1,98 @@
+package collections
+
+import (
+	"errors"
+	"sort"
+)
+
+// SortBy sorts l in place using less, stably.
+func SortBy[T CollectionElement](l *List[T], less func(a, b T) bool) {
+	sort.SliceStable(l.items, func(i, j int) bool { return less(l.items[i], l.items[j]) })
+}
+
+// SortedBy returns a new list containing l's elements sorted by less,
+// leaving l untouched.
+func SortedBy[T CollectionElement](l *List[T], less func(a, b T) bool) *List[T] {
+	result := NewListFromArray(l.items)
+	SortBy(result, less)
+	return result
+}
+
+// Reverse reverses l in place.
+func (l *List[T]) Reverse() {
+	for i, j := 0, len(l.items)-1; i < j; i, j = i+1, j-1 {
+		l.items[i], l.items[j] = l.items[j], l.items[i]
+	}
+}
+
+// Reversed returns a new list containing l's elements in reverse order,
+// leaving l untouched.
+func (l *List[T]) Reversed() *List[T] {
+	result := NewEmptyList[T](len(l.items))
+	for i := len(l.items) - 1; i >= 0; i-- {
+		result.Add(l.items[i])
+	}
+	return result
+}
+
+// IsSorted reports whether l is sorted according to less.
+func IsSorted[T CollectionElement](l *List[T], less func(a, b T) bool) bool {
+	for i := 1; i < len(l.items); i++ {
+		if less(l.items[i], l.items[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MinBy returns the element of l that sorts first according to less. Use
+// IndexOfMin for its index. Returns an error if l is empty.
+func MinBy[T CollectionElement](l *List[T], less func(a, b T) bool) (T, error) {
+	index, err := IndexOfMin(l, less)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return l.items[index], nil
+}
+
+// MaxBy returns the element of l that sorts last according to less. Use
+// IndexOfMax for its index. Returns an error if l is empty.
+func MaxBy[T CollectionElement](l *List[T], less func(a, b T) bool) (T, error) {
+	index, err := IndexOfMax(l, less)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return l.items[index], nil
+}
+
+// IndexOfMin returns the index of the element that sorts first according to
+// less. Returns an error if l is empty.
+func IndexOfMin[T CollectionElement](l *List[T], less func(a, b T) bool) (int, error) {
+	if l.Size() == 0 {
+		return -1, errors.New("EMPTY_LIST")
+	}
+	minIndex := 0
+	for i := 1; i < len(l.items); i++ {
+		if less(l.items[i], l.items[minIndex]) {
+			minIndex = i
+		}
+	}
+	return minIndex, nil
+}
+
+// IndexOfMax returns the index of the element that sorts last according to
+// less. Returns an error if l is empty.
+func IndexOfMax[T CollectionElement](l *List[T], less func(a, b T) bool) (int, error) {
+	if l.Size() == 0 {
+		return -1, errors.New("EMPTY_LIST")
+	}
+	maxIndex := 0
+	for i := 1; i < len(l.items); i++ {
+		if less(l.items[maxIndex], l.items[i]) {
+			maxIndex = i
+		}
+	}
+	return maxIndex, nil
+}
@@ -0,0 +1,101 @@
+package collections
+
+import "testing"
+
+func intLess(a, b int) bool { return a < b }
+
+func TestSortByMutatesInPlace(t *testing.T) {
+	l := NewListFromArray([]int{3, 1, 2})
+	SortBy(l, intLess)
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3})
+}
+
+func TestSortedByLeavesOriginalUntouched(t *testing.T) {
+	l := NewListFromArray([]int{3, 1, 2})
+	sorted := SortedBy(l, intLess)
+
+	assertIntSlice(t, sorted.ToArray(), []int{1, 2, 3})
+	assertIntSlice(t, l.ToArray(), []int{3, 1, 2})
+}
+
+func TestReverseMutatesInPlace(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	l.Reverse()
+	assertIntSlice(t, l.ToArray(), []int{3, 2, 1})
+}
+
+func TestReverseOddLength(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3, 4, 5})
+	l.Reverse()
+	assertIntSlice(t, l.ToArray(), []int{5, 4, 3, 2, 1})
+}
+
+func TestReversedLeavesOriginalUntouched(t *testing.T) {
+	l := NewListFromArray([]int{1, 2, 3})
+	reversed := l.Reversed()
+
+	assertIntSlice(t, reversed.ToArray(), []int{3, 2, 1})
+	assertIntSlice(t, l.ToArray(), []int{1, 2, 3})
+}
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted(NewListFromArray([]int{1, 2, 2, 3}), intLess) {
+		t.Error("expected sorted list to report true")
+	}
+	if IsSorted(NewListFromArray([]int{1, 3, 2}), intLess) {
+		t.Error("expected unsorted list to report false")
+	}
+	if !IsSorted(NewEmptyList[int](0), intLess) {
+		t.Error("expected empty list to report true")
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	l := NewListFromArray([]int{5, 1, 4, 2, 3})
+
+	min, err := MinBy(l, intLess)
+	if err != nil || min != 1 {
+		t.Errorf("MinBy got (%d, %v), want (1, nil)", min, err)
+	}
+
+	max, err := MaxBy(l, intLess)
+	if err != nil || max != 5 {
+		t.Errorf("MaxBy got (%d, %v), want (5, nil)", max, err)
+	}
+}
+
+func TestMinByMaxByEmptyList(t *testing.T) {
+	empty := NewEmptyList[int](0)
+
+	if _, err := MinBy(empty, intLess); err == nil {
+		t.Error("expected an error for MinBy on an empty list")
+	}
+	if _, err := MaxBy(empty, intLess); err == nil {
+		t.Error("expected an error for MaxBy on an empty list")
+	}
+}
+
+func TestIndexOfMinIndexOfMax(t *testing.T) {
+	l := NewListFromArray([]int{5, 1, 4, 2, 3})
+
+	minIndex, err := IndexOfMin(l, intLess)
+	if err != nil || minIndex != 1 {
+		t.Errorf("IndexOfMin got (%d, %v), want (1, nil)", minIndex, err)
+	}
+
+	maxIndex, err := IndexOfMax(l, intLess)
+	if err != nil || maxIndex != 0 {
+		t.Errorf("IndexOfMax got (%d, %v), want (0, nil)", maxIndex, err)
+	}
+}
+
+func TestIndexOfMinIndexOfMaxEmptyList(t *testing.T) {
+	empty := NewEmptyList[int](0)
+
+	if _, err := IndexOfMin(empty, intLess); err == nil {
+		t.Error("expected an error for IndexOfMin on an empty list")
+	}
+	if _, err := IndexOfMax(empty, intLess); err == nil {
+		t.Error("expected an error for IndexOfMax on an empty list")
+	}
+}
@@ -0,0 +1,205 @@
+// Package parallel provides concurrent counterparts of the List[T]
+// operations in the collections package, following the split samber/lo uses
+// between lo and lo/parallel. Every function takes an explicit concurrency
+// limit and runs work on a fixed-size worker pool reading from an index
+// channel, so callers control how much parallelism they introduce.
+package parallel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/zegabr/go-collections/collections"
+)
+
+// firstFailure collects the first error or panic raised by any worker so it
+// can be propagated back to the caller once all workers have finished.
+type firstFailure struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (f *firstFailure) record(err error) {
+	if err == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+func (f *firstFailure) get() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// runWorkerPool fans out [0, n) indices across concurrency workers, calling
+// work(i) for each one. It recovers panics in workers and converts them to
+// errors, and stops handing out new indices once ctx is cancelled or a
+// worker has already failed.
+func runWorkerPool(ctx context.Context, n int, concurrency int, work func(i int) error) error {
+	if n == 0 {
+		return nil
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > n {
+		concurrency = n
+	}
+
+	indexes := make(chan int)
+	failures := &firstFailure{}
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				failures.record(safeCall(i, work))
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+		if failures.get() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := failures.get(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// safeCall recovers a panic from work(i) and turns it into an error, so a
+// single bad element cannot take down the whole worker pool.
+func safeCall(i int, work func(i int) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("parallel: panic processing index %d: %v", i, r)
+		}
+	}()
+	return work(i)
+}
+
+// ParMap applies fn to every element of l concurrently, using up to
+// concurrency workers, and returns the results in the original order.
+func ParMap[T collections.CollectionElement, R collections.CollectionElement](
+	ctx context.Context, l *collections.List[T], fn func(item T, index int) R, concurrency int,
+) (*collections.List[R], error) {
+	items := l.ToArray()
+	results := make([]R, len(items))
+
+	err := runWorkerPool(ctx, len(items), concurrency, func(i int) error {
+		results[i] = fn(items[i], i)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections.NewListFromArray(results), nil
+}
+
+// ParFilter keeps the elements of l for which fn returns true, preserving
+// their original relative order, using up to concurrency workers.
+func ParFilter[T collections.CollectionElement](
+	ctx context.Context, l *collections.List[T], fn func(item T, index int) bool, concurrency int,
+) (*collections.List[T], error) {
+	items := l.ToArray()
+	keep := make([]bool, len(items))
+
+	err := runWorkerPool(ctx, len(items), concurrency, func(i int) error {
+		keep[i] = fn(items[i], i)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := collections.NewEmptyList[T](len(items))
+	for i, k := range keep {
+		if k {
+			result.Add(items[i])
+		}
+	}
+	return result, nil
+}
+
+// ParForEach calls fn for every element of l concurrently, using up to
+// concurrency workers. It is useful for side-effecting work where no result
+// list is needed.
+func ParForEach[T collections.CollectionElement](
+	ctx context.Context, l *collections.List[T], fn func(item T, index int), concurrency int,
+) error {
+	items := l.ToArray()
+	return runWorkerPool(ctx, len(items), concurrency, func(i int) error {
+		fn(items[i], i)
+		return nil
+	})
+}
+
+// ParReduce reduces l in parallel via a tree reduction: the list is split
+// into at most concurrency chunks of ceil(len/concurrency) elements (the last
+// may be shorter), each chunk is folded sequentially with reducer
+// starting from initialValue, and the per-chunk results are then combined in
+// order with combiner. Because initialValue seeds every chunk independently,
+// it must be an identity for combiner (combiner(initialValue, x) == x) —
+// passing a real starting value instead of a zero/identity will apply it once
+// per chunk and produce the wrong total.
+func ParReduce[T collections.CollectionElement](
+	ctx context.Context, l *collections.List[T], reducer func(acc, item T) T, initialValue T,
+	combiner func(a, b T) T, concurrency int,
+) (T, error) {
+	items := l.ToArray()
+	if len(items) == 0 {
+		return initialValue, nil
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > len(items) {
+		concurrency = len(items)
+	}
+
+	chunkSize := (len(items) + concurrency - 1) / concurrency
+	chunkCount := (len(items) + chunkSize - 1) / chunkSize
+	partials := make([]T, chunkCount)
+
+	err := runWorkerPool(ctx, chunkCount, chunkCount, func(i int) error {
+		start := i * chunkSize
+		end := start + chunkSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		acc := initialValue
+		for _, e := range items[start:end] {
+			acc = reducer(acc, e)
+		}
+		partials[i] = acc
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	result := partials[0]
+	for _, p := range partials[1:] {
+		result = combiner(result, p)
+	}
+	return result, nil
+}
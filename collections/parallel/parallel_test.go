@@ -0,0 +1,102 @@
+package parallel
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zegabr/go-collections/collections"
+)
+
+func TestParMapPreservesOrder(t *testing.T) {
+	input := collections.NewListFromArray([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	result, err := ParMap(context.Background(), input, func(item int, index int) int {
+		return item * item
+	}, 4)
+	if err != nil {
+		t.Fatalf("ParMap returned error: %v", err)
+	}
+
+	want := []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}
+	got := result.ToArray()
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParMapPropagatesPanic(t *testing.T) {
+	input := collections.NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	_, err := ParMap(context.Background(), input, func(item int, index int) int {
+		if item == 3 {
+			panic("boom")
+		}
+		return item
+	}, 3)
+
+	if err == nil {
+		t.Fatal("expected an error from a panicking worker, got nil")
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected error to mention the panic value, got: %v", err)
+	}
+}
+
+func TestParMapRespectsCancelledContext(t *testing.T) {
+	input := collections.NewListFromArray([]int{1, 2, 3, 4, 5})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParMap(ctx, input, func(item int, index int) int {
+		return item
+	}, 2)
+
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestParReduceSumsAcrossChunks(t *testing.T) {
+	input := collections.NewListFromArray([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	sum, err := ParReduce(context.Background(), input,
+		func(acc, item int) int { return acc + item },
+		0,
+		func(a, b int) int { return a + b },
+		4,
+	)
+	if err != nil {
+		t.Fatalf("ParReduce returned error: %v", err)
+	}
+	if sum != 55 {
+		t.Errorf("got %d, want 55", sum)
+	}
+}
+
+func TestParForEachVisitsEveryElement(t *testing.T) {
+	input := collections.NewListFromArray([]int{1, 2, 3, 4, 5})
+	visited := make([]bool, input.Size())
+	var mu sync.Mutex
+
+	err := ParForEach(context.Background(), input, func(item int, index int) {
+		mu.Lock()
+		visited[index] = true
+		mu.Unlock()
+	}, 3)
+	if err != nil {
+		t.Fatalf("ParForEach returned error: %v", err)
+	}
+	for i, v := range visited {
+		if !v {
+			t.Errorf("index %d was never visited", i)
+		}
+	}
+}
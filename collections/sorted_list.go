@@ -0,0 +1,66 @@
+package collections
+
+import "sort"
+
+// SortedList is a List[T] variant that maintains its elements in order under
+// a user-supplied comparator.
+type SortedList[T CollectionElement] struct {
+	list *List[T]
+	less func(a, b T) bool
+}
+
+// NewSortedList creates an empty SortedList ordered by less.
+func NewSortedList[T CollectionElement](less func(a, b T) bool) *SortedList[T] {
+	return &SortedList[T]{list: NewEmptyList[T](0), less: less}
+}
+
+// NewSortedListFromArray creates a SortedList ordered by less, containing a
+// sorted copy of array.
+func NewSortedListFromArray[T CollectionElement](array []T, less func(a, b T) bool) *SortedList[T] {
+	sl := NewSortedList(less)
+	sl.list = SortedBy(NewListFromArray(array), less)
+	return sl
+}
+
+// searchIndex returns the index of the first element not less than item,
+// i.e. where item belongs to keep the list sorted.
+func (sl *SortedList[T]) searchIndex(item T) int {
+	return sort.Search(sl.list.Size(), func(i int) bool {
+		return !sl.less(sl.list.items[i], item)
+	})
+}
+
+// Add inserts item at its sorted position: O(log n) to locate it via binary
+// search, O(n) to shift the remaining elements.
+func (sl *SortedList[T]) Add(item T) {
+	index := sl.searchIndex(item)
+	sl.list.items = append(sl.list.items, item)
+	copy(sl.list.items[index+1:], sl.list.items[index:])
+	sl.list.items[index] = item
+}
+
+// Contains reports whether item is present, using an O(log n) binary search.
+func (sl *SortedList[T]) Contains(item T) bool {
+	index := sl.searchIndex(item)
+	return index < sl.list.Size() && sl.list.items[index] == item
+}
+
+// Get returns the element at index, or an error if index is out of range.
+func (sl *SortedList[T]) Get(index int) (T, error) {
+	return sl.list.Get(index)
+}
+
+// Size returns the number of elements in the list.
+func (sl *SortedList[T]) Size() int {
+	return sl.list.Size()
+}
+
+// ToArray returns a slice containing the elements of the list, in order.
+func (sl *SortedList[T]) ToArray() []T {
+	return sl.list.ToArray()
+}
+
+// ToList returns the underlying, already-sorted List[T].
+func (sl *SortedList[T]) ToList() *List[T] {
+	return sl.list
+}
@@ -0,0 +1,74 @@
+package collections
+
+import "testing"
+
+func TestSortedListAddKeepsOrder(t *testing.T) {
+	sl := NewSortedList[int](intLess)
+
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		sl.Add(v)
+	}
+
+	assertIntSlice(t, sl.ToArray(), []int{1, 2, 3, 4, 5})
+}
+
+func TestSortedListAddAtBoundaries(t *testing.T) {
+	sl := NewSortedList[int](intLess)
+	sl.Add(2)
+	sl.Add(4)
+
+	sl.Add(1) // belongs before everything
+	sl.Add(5) // belongs after everything
+	sl.Add(3) // belongs in the middle
+
+	assertIntSlice(t, sl.ToArray(), []int{1, 2, 3, 4, 5})
+}
+
+func TestSortedListAddDuplicate(t *testing.T) {
+	sl := NewSortedList[int](intLess)
+	sl.Add(2)
+	sl.Add(2)
+	sl.Add(1)
+
+	assertIntSlice(t, sl.ToArray(), []int{1, 2, 2})
+}
+
+func TestNewSortedListFromArraySortsInput(t *testing.T) {
+	sl := NewSortedListFromArray([]int{3, 1, 2}, intLess)
+	assertIntSlice(t, sl.ToArray(), []int{1, 2, 3})
+}
+
+func TestSortedListContains(t *testing.T) {
+	sl := NewSortedListFromArray([]int{1, 3, 5, 7, 9}, intLess)
+
+	for _, v := range []int{1, 5, 9} {
+		if !sl.Contains(v) {
+			t.Errorf("expected Contains(%d) to be true", v)
+		}
+	}
+	for _, v := range []int{0, 4, 10} {
+		if sl.Contains(v) {
+			t.Errorf("expected Contains(%d) to be false", v)
+		}
+	}
+}
+
+func TestSortedListContainsEmptyList(t *testing.T) {
+	sl := NewSortedList[int](intLess)
+	if sl.Contains(1) {
+		t.Error("expected Contains on an empty list to be false")
+	}
+}
+
+func TestSortedListGet(t *testing.T) {
+	sl := NewSortedListFromArray([]int{3, 1, 2}, intLess)
+
+	v, err := sl.Get(1)
+	if err != nil || v != 2 {
+		t.Errorf("Get(1) got (%d, %v), want (2, nil)", v, err)
+	}
+
+	if _, err := sl.Get(10); err == nil {
+		t.Error("expected an error for an out-of-range index")
+	}
+}
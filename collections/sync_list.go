@@ -0,0 +1,122 @@
+package collections
+
+import "sync"
+
+// SyncList is a concurrent-safe variant of List[T]. Read methods take a read
+// lock, mutating methods take a write lock, and iteration methods (Where,
+// Map, Reduce, ToArray) snapshot the underlying slice under a read lock
+// before invoking user callbacks.
+type SyncList[T CollectionElement] struct {
+	mu   sync.RWMutex
+	list *List[T]
+}
+
+// NewSyncList creates an empty, concurrent-safe list with predefined capacity.
+func NewSyncList[T CollectionElement](capacity int) *SyncList[T] {
+	return &SyncList[T]{list: NewEmptyList[T](capacity)}
+}
+
+// NewSyncListFromArray creates a concurrent-safe list from an array.
+func NewSyncListFromArray[T CollectionElement](array []T) *SyncList[T] {
+	return &SyncList[T]{list: NewListFromArray(array)}
+}
+
+// Add appends an element to the list.
+func (l *SyncList[T]) Add(item T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Add(item)
+}
+
+// Get returns the element at index, or an error if index is out of range.
+func (l *SyncList[T]) Get(index int) (T, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Get(index)
+}
+
+// Contains checks whether an element is present in the list.
+func (l *SyncList[T]) Contains(item T) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Contains(item)
+}
+
+// RemoveFirst removes the first occurence of item from the list.
+// Returns an error if the element is not present in the list.
+func (l *SyncList[T]) RemoveFirst(item T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.RemoveFirst(item)
+}
+
+// RemoveAll removes every occurence of item from the list.
+// Returns an error if the element is not present in the list.
+func (l *SyncList[T]) RemoveAll(item T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.RemoveAll(item)
+}
+
+// Where returns a new (plain) List[T] filtered by f.
+func (l *SyncList[T]) Where(f func(T) bool) *List[T] {
+	snapshot := l.snapshot()
+	return snapshot.Where(f)
+}
+
+// Map applies callback over a snapshot of the list and returns a
+// ListTransformation like List[T].Map.
+//
+// Deprecated: use the package-level MapList instead.
+func (l *SyncList[T]) Map(callback listMapFunction[T]) ListTransformation {
+	snapshot := l.snapshot()
+	return snapshot.Map(callback)
+}
+
+// Reduce folds the list down to a single value of type T.
+func (l *SyncList[T]) Reduce(callback listReduceFunction[T], initialValue T) T {
+	snapshot := l.snapshot()
+	return snapshot.Reduce(callback, initialValue)
+}
+
+// Extend concatenates l2's elements onto l.
+func (l *SyncList[T]) Extend(l2 *SyncList[T]) {
+	other := l2.ToArray()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Extend(NewListFromArray(other))
+}
+
+// Distinct returns a new (plain) List[T] containing the unique elements.
+func (l *SyncList[T]) Distinct() *List[T] {
+	snapshot := l.snapshot()
+	return snapshot.Distinct()
+}
+
+// Size returns the number of elements in the list.
+func (l *SyncList[T]) Size() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Size()
+}
+
+// ToArray returns a snapshot slice of the list's elements.
+func (l *SyncList[T]) ToArray() []T {
+	return l.snapshot().ToArray()
+}
+
+// Atomic runs fn with exclusive access to the underlying List[T] under a
+// single write lock.
+func (l *SyncList[T]) Atomic(fn func(*List[T])) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fn(l.list)
+}
+
+// snapshot copies the underlying slice under a read lock and returns it as a
+// plain List[T].
+func (l *SyncList[T]) snapshot() *List[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return NewListFromArray(l.list.items)
+}
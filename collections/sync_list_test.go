@@ -0,0 +1,88 @@
+package collections
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSyncListConcurrentMutation(t *testing.T) {
+	l := NewSyncList[int](0)
+
+	var wg sync.WaitGroup
+	const goroutines = 20
+	const perGoroutine = 50
+
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				l.Add(i)
+				l.Contains(i)
+				l.Size()
+				l.ToArray()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if want := goroutines * perGoroutine; l.Size() != want {
+		t.Fatalf("got size %d, want %d", l.Size(), want)
+	}
+}
+
+func TestSyncListWhereCanReenterWithoutDeadlock(t *testing.T) {
+	l := NewSyncListFromArray([]int{1, 2, 3, 4, 5})
+
+	done := make(chan *List[int], 1)
+	go func() {
+		result := l.Where(func(item int) bool {
+			l.Size()
+			l.Contains(item)
+			return item%2 == 0
+		})
+		done <- result
+	}()
+
+	var result *List[int]
+	select {
+	case result = <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Where deadlocked when its callback re-entered the list")
+	}
+
+	got := result.ToArray()
+	want := []int{2, 4}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSyncListMapCanReenterWithoutDeadlock(t *testing.T) {
+	l := NewSyncListFromArray([]int{1, 2, 3})
+
+	done := make(chan ListTransformation, 1)
+	go func() {
+		done <- l.Map(func(item int, index int) any {
+			l.Add(item) // re-enters the list from inside the callback
+			return item * 2
+		})
+	}()
+
+	var lt ListTransformation
+	select {
+	case lt = <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Map deadlocked when its callback re-entered the list")
+	}
+
+	if len(lt.values) != 3 {
+		t.Fatalf("got %d transformed values, want 3", len(lt.values))
+	}
+}